@@ -0,0 +1,150 @@
+package cortex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
+)
+
+// BatchResult carries the outcome of creating a single node within a
+// CreateNodesBatch call. Index matches the position of the node in the
+// input slice; Err is non-nil if the server rejected that specific node
+// (e.g. an unparseable tag) without affecting its siblings.
+type BatchResult struct {
+	Index int
+	ID    string
+	Err   error
+}
+
+// BatchOption configures CreateNodesBatch.
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	chunkSize   int
+	concurrency int
+}
+
+// WithChunkSize sets how many nodes are sent per BatchCreateNodes RPC.
+// Defaults to 500. n <= 0 is ignored (the default is kept) rather than
+// producing a chunk that never advances.
+func WithChunkSize(n int) BatchOption {
+	return func(c *batchConfig) {
+		if n > 0 {
+			c.chunkSize = n
+		}
+	}
+}
+
+// WithConcurrency sets how many chunks may be in flight at once. Defaults
+// to 1 (chunks sent one at a time). k <= 0 is ignored (the default is
+// kept) rather than blocking forever or panicking on an invalid channel
+// size.
+func WithConcurrency(k int) BatchOption {
+	return func(c *batchConfig) {
+		if k > 0 {
+			c.concurrency = k
+		}
+	}
+}
+
+// CreateNodesBatch stores many nodes in one logical operation, chunking the
+// input and reporting per-node success or failure. A single malformed node
+// (e.g. an unparseable tag) fails only its own BatchResult. A
+// transport-level failure (e.g. a dropped connection) aborts only the
+// chunk it hit: that chunk's results carry the failure in their Err field,
+// every other chunk's results are still returned, and the first such error
+// is also returned alongside them so callers can tell the batch was only
+// partially applied without having to inspect every result.
+func (c *Client) CreateNodesBatch(ctx context.Context, nodes []Node, opts ...BatchOption) ([]BatchResult, error) {
+	cfg := &batchConfig{chunkSize: 500, concurrency: 1}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	results := make([]BatchResult, len(nodes))
+	sem := make(chan struct{}, cfg.concurrency)
+	var wg sync.WaitGroup
+	var firstErr error
+	var mu sync.Mutex
+
+	for start := 0; start < len(nodes); start += cfg.chunkSize {
+		end := start + cfg.chunkSize
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		chunk := nodes[start:end]
+		offset := start
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			chunkResults, err := c.createNodesChunk(ctx, offset, chunk)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				for i := offset; i < offset+len(chunk); i++ {
+					results[i] = BatchResult{Index: i, Err: err}
+				}
+				return
+			}
+			for _, r := range chunkResults {
+				results[r.Index] = r
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results, firstErr
+}
+
+// createNodesChunk sends a single chunk over the BatchCreateNodes RPC,
+// streaming one CreateNodeRequest per node and collecting the server's
+// per-node acknowledgements as they arrive.
+func (c *Client) createNodesChunk(ctx context.Context, offset int, chunk []Node) ([]BatchResult, error) {
+	stream, err := c.svc.BatchCreateNodes(ctx)
+	if err != nil {
+		return nil, wrapErr("CreateNodesBatch", err)
+	}
+
+	for _, n := range chunk {
+		req := &pb.CreateNodeRequest{
+			Kind:        n.Kind,
+			Title:       n.Title,
+			Body:        orDefault(n.Body, n.Title),
+			Importance:  n.Importance,
+			Tags:        n.Tags,
+			SourceAgent: n.SourceAgent,
+		}
+		if n.Metadata != nil {
+			req.Metadata = n.Metadata
+		}
+		if err := stream.Send(req); err != nil {
+			return nil, wrapErr("CreateNodesBatch", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, wrapErr("CreateNodesBatch", err)
+	}
+
+	results := make([]BatchResult, 0, len(chunk))
+	for i := 0; i < len(chunk); i++ {
+		ack, err := stream.Recv()
+		if err != nil {
+			return nil, wrapErr("CreateNodesBatch", err)
+		}
+		result := BatchResult{Index: offset + int(ack.Index), ID: ack.Id}
+		if ack.Error != "" {
+			result.Err = fmt.Errorf("cortex: node %d: %s", result.Index, ack.Error)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
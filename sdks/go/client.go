@@ -6,7 +6,6 @@ import (
 
 	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // Client is a connected Cortex client.
@@ -26,8 +25,18 @@ type Client struct {
 }
 
 // Connect creates a new Client connected to addr (e.g. "localhost:9090").
-func Connect(addr string) (*Client, error) {
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// By default the connection is unauthenticated and unencrypted; pass
+// options such as WithTLS, WithMTLS, or WithBearerToken to secure it.
+func Connect(addr string, opts ...Option) (*Client, error) {
+	cfg := &connectConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.err != nil {
+		return nil, cfg.err
+	}
+
+	conn, err := grpc.Dial(addr, cfg.buildDialOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("cortex: connect: %w", err)
 	}
@@ -54,16 +63,17 @@ func (c *Client) CreateNode(ctx context.Context, n Node) (string, error) {
 	}
 	resp, err := c.svc.CreateNode(ctx, req)
 	if err != nil {
-		return "", fmt.Errorf("cortex: CreateNode: %w", err)
+		return "", wrapErr("CreateNode", err)
 	}
 	return resp.Id, nil
 }
 
-// GetNode retrieves a node by ID. Returns nil if not found.
+// GetNode retrieves a node by ID. Returns (nil, ErrNodeNotFound) if no node
+// exists with that ID.
 func (c *Client) GetNode(ctx context.Context, id string) (*pb.NodeResponse, error) {
 	resp, err := c.svc.GetNode(ctx, &pb.GetNodeRequest{Id: id})
 	if err != nil {
-		return nil, fmt.Errorf("cortex: GetNode: %w", err)
+		return nil, wrapErr("GetNode", err)
 	}
 	return resp, nil
 }
@@ -75,7 +85,7 @@ func (c *Client) Search(ctx context.Context, query string, limit int) ([]SearchR
 		Limit: uint32(limit),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("cortex: Search: %w", err)
+		return nil, wrapErr("Search", err)
 	}
 	results := make([]SearchResult, len(resp.Results))
 	for i, r := range resp.Results {
@@ -109,7 +119,7 @@ func (c *Client) SearchHybrid(
 		Limit:     uint32(limit),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("cortex: SearchHybrid: %w", err)
+		return nil, wrapErr("SearchHybrid", err)
 	}
 	results := make([]HybridResult, len(resp.Results))
 	for i, r := range resp.Results {
@@ -134,7 +144,7 @@ func (c *Client) SearchHybrid(
 func (c *Client) Briefing(ctx context.Context, agentID string) (string, error) {
 	resp, err := c.svc.GetBriefing(ctx, &pb.BriefingRequest{AgentId: agentID})
 	if err != nil {
-		return "", fmt.Errorf("cortex: Briefing: %w", err)
+		return "", wrapErr("Briefing", err)
 	}
 	return resp.Rendered, nil
 }
@@ -143,7 +153,7 @@ func (c *Client) Briefing(ctx context.Context, agentID string) (string, error) {
 func (c *Client) BriefingCompact(ctx context.Context, agentID string) (string, error) {
 	resp, err := c.svc.GetBriefing(ctx, &pb.BriefingRequest{AgentId: agentID, Compact: true})
 	if err != nil {
-		return "", fmt.Errorf("cortex: BriefingCompact: %w", err)
+		return "", wrapErr("BriefingCompact", err)
 	}
 	return resp.Rendered, nil
 }
@@ -155,7 +165,7 @@ func (c *Client) Traverse(ctx context.Context, startID string, depth uint32) (*S
 		MaxDepth: depth,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("cortex: Traverse: %w", err)
+		return nil, wrapErr("Traverse", err)
 	}
 	ids := make([]string, len(resp.Nodes))
 	for i, n := range resp.Nodes {
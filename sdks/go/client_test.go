@@ -2,9 +2,12 @@ package cortex
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"testing"
+	"time"
 
 	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
 	"google.golang.org/grpc"
@@ -40,10 +43,14 @@ func (s *testServer) CreateNode(_ context.Context, req *pb.CreateNodeRequest) (*
 		body = req.Title
 	}
 	node := &pb.NodeResponse{
-		Id:    id,
-		Kind:  req.Kind,
-		Title: req.Title,
-		Body:  body,
+		Id:          id,
+		Kind:        req.Kind,
+		Title:       req.Title,
+		Body:        body,
+		Importance:  req.Importance,
+		Tags:        req.Tags,
+		SourceAgent: req.SourceAgent,
+		Metadata:    req.Metadata,
 	}
 	s.nodes[id] = node
 	return node, nil
@@ -86,6 +93,120 @@ func (s *testServer) Traverse(_ context.Context, _ *pb.TraverseRequest) (*pb.Sub
 	}, nil
 }
 
+func (s *testServer) EstablishPeering(_ context.Context, _ *pb.EstablishPeeringRequest) (*pb.EstablishPeeringResponse, error) {
+	return &pb.EstablishPeeringResponse{Token: "test-token"}, nil
+}
+
+// AcceptPeering sends an Updated event (not yet replicated) followed by a
+// Created event, then ends the stream — used to verify that AcceptPeering
+// skips the unsupported kind and keeps applying later events rather than
+// aborting on it.
+func (s *testServer) AcceptPeering(_ *pb.AcceptPeeringRequest, stream pb.CortexService_AcceptPeeringServer) error {
+	if err := stream.Send(&pb.Event{Kind: int32(Updated)}); err != nil {
+		return err
+	}
+	return stream.Send(&pb.Event{
+		Kind: int32(Created),
+		Node: &pb.NodeResponse{Kind: "fact", Title: "From peer", Body: "From peer"},
+	})
+}
+
+func (s *testServer) FindByContentHash(_ context.Context, req *pb.FindByContentHashRequest) (*pb.FindByContentHashResponse, error) {
+	for id, node := range s.nodes {
+		if contentHash(node.Kind, node.Title, node.Body) == req.ContentHash {
+			return &pb.FindByContentHashResponse{Id: id}, nil
+		}
+	}
+	return &pb.FindByContentHashResponse{}, nil
+}
+
+func (s *testServer) BatchCreateNodes(stream pb.CortexService_BatchCreateNodesServer) error {
+	var index uint32
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if req.Kind == "boom" {
+			// Simulates a transport-level failure (e.g. a dropped
+			// connection) that aborts the whole chunk, as opposed to a
+			// per-node validation error reported via ack.Error.
+			return status.Errorf(codes.Unavailable, "simulated transport failure")
+		}
+
+		ack := &pb.BatchCreateNodesAck{Index: index}
+		if req.Kind == "" {
+			ack.Error = "kind must not be empty"
+		} else {
+			s.nextID++
+			id := fmt.Sprintf("node-%d", s.nextID)
+			body := req.Body
+			if body == "" {
+				body = req.Title
+			}
+			s.nodes[id] = &pb.NodeResponse{Id: id, Kind: req.Kind, Title: req.Title, Body: body}
+			ack.Id = id
+		}
+		if err := stream.Send(ack); err != nil {
+			return err
+		}
+		index++
+	}
+}
+
+func (s *testServer) Watch(req *pb.WatchRequest, stream pb.CortexService_WatchServer) error {
+	for _, node := range s.nodes {
+		return stream.Send(&pb.Event{Kind: int32(Created), Node: node, Revision: 1})
+	}
+	return nil
+}
+
+func (s *testServer) SearchStream(req *pb.SimilaritySearchRequest, stream pb.CortexService_SearchStreamServer) error {
+	var sent uint32
+	for _, node := range s.nodes {
+		if req.Limit > 0 && sent >= req.Limit {
+			break
+		}
+		if err := stream.Send(&pb.SearchResultEntry{Node: node, Score: 0.9}); err != nil {
+			return err
+		}
+		sent++
+		// A query of "boom" simulates a mid-stream transport failure after
+		// at least one result has already been delivered, as opposed to
+		// SearchStream failing to even start.
+		if req.Query == "boom" {
+			return status.Errorf(codes.Unavailable, "simulated mid-stream failure")
+		}
+	}
+	return nil
+}
+
+func (s *testServer) HybridSearchStream(req *pb.HybridSearchRequest, stream pb.CortexService_HybridSearchStreamServer) error {
+	var sent uint32
+	for _, node := range s.nodes {
+		if req.Limit > 0 && sent >= req.Limit {
+			break
+		}
+		if err := stream.Send(&pb.HybridSearchResultEntry{Node: node, CombinedScore: 0.9}); err != nil {
+			return err
+		}
+		sent++
+	}
+	return nil
+}
+
+func (s *testServer) TraverseStream(req *pb.TraverseRequest, stream pb.CortexService_TraverseStreamServer) error {
+	nodes := make([]*pb.NodeResponse, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		nodes = append(nodes, node)
+	}
+	return stream.Send(&pb.TraverseFrameResponse{Nodes: nodes})
+}
+
 // ---------------------------------------------------------------------------
 // Test helper: start bufconn server, return connected Client + teardown func
 // ---------------------------------------------------------------------------
@@ -238,6 +359,404 @@ func TestSearchEmpty(t *testing.T) {
 	}
 }
 
+// TestSearchStream creates a node then drains the streaming search results.
+func TestSearchStream(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := client.CreateNode(ctx, Node{Kind: "fact", Title: "Streamed fact"})
+	if err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	items, cancel, err := client.SearchStream(ctx, "fact", 10)
+	if err != nil {
+		t.Fatalf("SearchStream returned unexpected error: %v", err)
+	}
+	defer cancel()
+
+	var got []SearchResult
+	for item := range items {
+		if item.Err != nil {
+			t.Fatalf("unexpected item error: %v", item.Err)
+		}
+		got = append(got, item.Result)
+	}
+	if len(got) == 0 {
+		t.Fatal("expected at least one streamed result")
+	}
+	if got[0].NodeID == "" {
+		t.Error("expected non-empty NodeID in streamed result")
+	}
+}
+
+// TestSearchStreamSurfacesMidStreamError verifies that a stream that breaks
+// after delivering results (as opposed to finishing cleanly) is reported to
+// the caller via the final item's Err field rather than the channel just
+// closing as if nothing went wrong.
+func TestSearchStreamSurfacesMidStreamError(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := client.CreateNode(ctx, Node{Kind: "fact", Title: "Streamed fact"})
+	if err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	items, cancel, err := client.SearchStream(ctx, "boom", 10)
+	if err != nil {
+		t.Fatalf("SearchStream returned unexpected error: %v", err)
+	}
+	defer cancel()
+
+	var lastErr error
+	var resultCount int
+	for item := range items {
+		if item.Err != nil {
+			lastErr = item.Err
+			continue
+		}
+		resultCount++
+	}
+	if resultCount == 0 {
+		t.Fatal("expected at least one result delivered before the stream broke")
+	}
+	if lastErr == nil {
+		t.Fatal("expected the mid-stream failure to be surfaced via Err")
+	}
+}
+
+// TestSearchHybridStream creates a node then drains the streaming hybrid
+// search results.
+func TestSearchHybridStream(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := client.CreateNode(ctx, Node{Kind: "fact", Title: "Streamed fact"})
+	if err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	items, cancel, err := client.SearchHybridStream(ctx, "fact", nil, 10)
+	if err != nil {
+		t.Fatalf("SearchHybridStream returned unexpected error: %v", err)
+	}
+	defer cancel()
+
+	var got []HybridResult
+	for item := range items {
+		if item.Err != nil {
+			t.Fatalf("unexpected item error: %v", item.Err)
+		}
+		got = append(got, item.Result)
+	}
+	if len(got) == 0 {
+		t.Fatal("expected at least one streamed hybrid result")
+	}
+	if got[0].NodeID == "" {
+		t.Error("expected non-empty NodeID in streamed hybrid result")
+	}
+}
+
+// TestTraverseStream creates a node then drains the streamed traversal
+// frames.
+func TestTraverseStream(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	id, err := client.CreateNode(ctx, Node{Kind: "fact", Title: "Traversal root"})
+	if err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	items, cancel, err := client.TraverseStream(ctx, id, 2)
+	if err != nil {
+		t.Fatalf("TraverseStream returned unexpected error: %v", err)
+	}
+	defer cancel()
+
+	var got []TraverseFrame
+	for item := range items {
+		if item.Err != nil {
+			t.Fatalf("unexpected item error: %v", item.Err)
+		}
+		got = append(got, item.Frame)
+	}
+	if len(got) == 0 {
+		t.Fatal("expected at least one streamed traversal frame")
+	}
+	if len(got[0].NodeIDs) == 0 {
+		t.Error("expected non-empty NodeIDs in streamed traversal frame")
+	}
+}
+
+// TestCreateNodesBatch creates several nodes in one batch call, including
+// one with an invalid (empty) kind, and verifies per-item results.
+func TestCreateNodesBatch(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	nodes := []Node{
+		{Kind: "fact", Title: "First"},
+		{Kind: "", Title: "Invalid"},
+		{Kind: "fact", Title: "Third"},
+	}
+
+	results, err := client.CreateNodesBatch(ctx, nodes, WithChunkSize(2), WithConcurrency(2))
+	if err != nil {
+		t.Fatalf("CreateNodesBatch returned unexpected error: %v", err)
+	}
+	if len(results) != len(nodes) {
+		t.Fatalf("expected %d results, got %d", len(nodes), len(results))
+	}
+	if results[0].Err != nil || results[0].ID == "" {
+		t.Errorf("expected result[0] to succeed, got %+v", results[0])
+	}
+	if results[1].Err == nil {
+		t.Error("expected result[1] to carry a per-node error for empty kind")
+	}
+	if results[2].Err != nil || results[2].ID == "" {
+		t.Errorf("expected result[2] to succeed, got %+v", results[2])
+	}
+}
+
+// TestCreateNodesBatchIgnoresInvalidOptions verifies that a non-positive
+// WithChunkSize or WithConcurrency falls back to the documented defaults
+// instead of deadlocking (zero concurrency), panicking (negative
+// concurrency), or looping forever (non-positive chunk size).
+func TestCreateNodesBatchIgnoresInvalidOptions(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	nodes := []Node{{Kind: "fact", Title: "A"}, {Kind: "fact", Title: "B"}}
+
+	results, err := client.CreateNodesBatch(ctx, nodes, WithChunkSize(0), WithConcurrency(-1))
+	if err != nil {
+		t.Fatalf("CreateNodesBatch returned unexpected error: %v", err)
+	}
+	if len(results) != len(nodes) {
+		t.Fatalf("expected %d results, got %d", len(nodes), len(results))
+	}
+}
+
+// TestCreateNodesBatchPartialChunkFailureReturnsOtherResults verifies that
+// a transport-level failure in one chunk doesn't discard the results of
+// chunks that succeeded concurrently: the caller should get back every
+// other chunk's results alongside the error, not nil.
+func TestCreateNodesBatchPartialChunkFailureReturnsOtherResults(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	nodes := []Node{
+		{Kind: "fact", Title: "Good 1"},
+		{Kind: "boom", Title: "Triggers a transport-level failure"},
+		{Kind: "fact", Title: "Good 2"},
+	}
+
+	results, err := client.CreateNodesBatch(ctx, nodes, WithChunkSize(1), WithConcurrency(3))
+	if err == nil {
+		t.Fatal("expected a transport-level error from the failing chunk")
+	}
+	if len(results) != len(nodes) {
+		t.Fatalf("expected %d results despite the failure, got %d", len(nodes), len(results))
+	}
+	if results[0].Err != nil || results[0].ID == "" {
+		t.Errorf("expected result[0] to succeed, got %+v", results[0])
+	}
+	if results[1].Err == nil {
+		t.Error("expected result[1] to carry the transport-level error")
+	}
+	if results[2].Err != nil || results[2].ID == "" {
+		t.Errorf("expected result[2] to succeed despite result[1]'s chunk failing, got %+v", results[2])
+	}
+}
+
+// TestWatch seeds a node then verifies a Created event is delivered.
+func TestWatch(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := client.CreateNode(ctx, Node{Kind: "event", Title: "Watched node"})
+	if err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	events, err := client.Watch(ctx, WatchRequest{Kinds: []string{"event"}})
+	if err != nil {
+		t.Fatalf("Watch returned unexpected error: %v", err)
+	}
+
+	select {
+	case ev := <-events:
+		if ev.Kind != Created {
+			t.Errorf("expected Created event, got %v", ev.Kind)
+		}
+		if ev.Node == nil || ev.Node.Title != "Watched node" {
+			t.Errorf("unexpected node in event: %+v", ev.Node)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+	}
+}
+
+// TestContentHashDeterministic verifies that contentHash is stable across
+// calls and distinguishes different node content, as required for peer
+// dedup to converge consistently on both sides of a peering relationship.
+func TestContentHashDeterministic(t *testing.T) {
+	a := contentHash("fact", "Title", "Body")
+	b := contentHash("fact", "Title", "Body")
+	if a != b {
+		t.Errorf("expected contentHash to be deterministic, got %q and %q", a, b)
+	}
+
+	c := contentHash("fact", "Title", "Different body")
+	if a == c {
+		t.Error("expected different content to produce different hashes")
+	}
+}
+
+// TestApplyPeerEventCreatesNewNode verifies a Created peer event replicates
+// into the local graph when no matching node exists yet.
+func TestApplyPeerEventCreatesNewNode(t *testing.T) {
+	client, srv, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	ev := &pb.Event{
+		Kind: int32(Created),
+		Node: &pb.NodeResponse{Kind: "fact", Title: "Peer fact", Body: "Peer fact"},
+	}
+	if err := client.applyPeerEvent(ctx, ev); err != nil {
+		t.Fatalf("applyPeerEvent: %v", err)
+	}
+	if len(srv.nodes) != 1 {
+		t.Fatalf("expected 1 node to be replicated locally, got %d", len(srv.nodes))
+	}
+}
+
+// TestApplyPeerEventDedupesByContentHash verifies a Created peer event for
+// a node that already exists locally (by content hash) is not duplicated.
+func TestApplyPeerEventDedupesByContentHash(t *testing.T) {
+	client, srv, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := client.CreateNode(ctx, Node{Kind: "fact", Title: "Existing", Body: "Existing"})
+	if err != nil {
+		t.Fatalf("CreateNode: %v", err)
+	}
+
+	ev := &pb.Event{
+		Kind: int32(Created),
+		Node: &pb.NodeResponse{Kind: "fact", Title: "Existing", Body: "Existing"},
+	}
+	if err := client.applyPeerEvent(ctx, ev); err != nil {
+		t.Fatalf("applyPeerEvent: %v", err)
+	}
+	if len(srv.nodes) != 1 {
+		t.Errorf("expected dedup to avoid creating a duplicate node, got %d nodes", len(srv.nodes))
+	}
+}
+
+// TestApplyPeerEventRejectsUnsupportedKinds verifies that event kinds
+// without replication support are rejected explicitly instead of being
+// silently dropped.
+func TestApplyPeerEventRejectsUnsupportedKinds(t *testing.T) {
+	client, _, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for _, kind := range []EventKind{Updated, Deleted, EdgeAdded, EdgeRemoved} {
+		ev := &pb.Event{Kind: int32(kind)}
+		if err := client.applyPeerEvent(ctx, ev); err == nil {
+			t.Errorf("expected applyPeerEvent to reject unsupported kind %v, got nil error", kind)
+		}
+	}
+}
+
+// TestApplyPeerEventCopiesAllReplicableFields verifies that replicating a
+// Created peer event carries over Importance, Tags, and SourceAgent, not
+// just Kind/Title/Body — PeeringConfig filters by importance and tags, so
+// dropping them on replication would defeat the feature.
+func TestApplyPeerEventCopiesAllReplicableFields(t *testing.T) {
+	client, srv, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	ev := &pb.Event{
+		Kind: int32(Created),
+		Node: &pb.NodeResponse{
+			Kind:        "fact",
+			Title:       "Peer fact",
+			Body:        "Peer fact",
+			Importance:  0.75,
+			Tags:        []string{"peer", "imported"},
+			SourceAgent: "peer-agent",
+		},
+	}
+	if err := client.applyPeerEvent(ctx, ev); err != nil {
+		t.Fatalf("applyPeerEvent: %v", err)
+	}
+
+	var created *pb.NodeResponse
+	for _, n := range srv.nodes {
+		created = n
+	}
+	if created == nil {
+		t.Fatal("expected a node to be replicated locally")
+	}
+	if created.Importance != 0.75 {
+		t.Errorf("Importance = %v, want 0.75", created.Importance)
+	}
+	if len(created.Tags) != 2 {
+		t.Errorf("Tags = %v, want 2 tags", created.Tags)
+	}
+	if created.SourceAgent != "peer-agent" {
+		t.Errorf("SourceAgent = %q, want %q", created.SourceAgent, "peer-agent")
+	}
+}
+
+// TestAcceptPeeringSkipsUnsupportedEventsAndKeepsGoing verifies that an
+// Updated event (not yet replicated) is skipped rather than tearing down
+// the whole peering stream, and that the Created event sent right after it
+// still gets applied.
+func TestAcceptPeeringSkipsUnsupportedEventsAndKeepsGoing(t *testing.T) {
+	client, srv, cleanup := newTestClient(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	if err := client.AcceptPeering(ctx, "test-token"); err == nil {
+		t.Fatal("expected AcceptPeering to return an error once the mock stream ends")
+	}
+
+	if len(srv.nodes) != 1 {
+		t.Fatalf("expected the Created event after the skipped Updated event to still be applied, got %d nodes", len(srv.nodes))
+	}
+}
+
 // TestBriefing verifies that Briefing returns a non-empty rendered string.
 func TestBriefing(t *testing.T) {
 	client, _, cleanup := newTestClient(t)
@@ -287,24 +806,29 @@ func TestGetNodeFound(t *testing.T) {
 	}
 }
 
-// TestGetNodeNotFound verifies that GetNode returns an error for unknown IDs.
-// (The Go SDK propagates the gRPC NOT_FOUND error; it does not return nil.)
+// TestGetNodeNotFound verifies that GetNode returns (nil, ErrNodeNotFound)
+// for unknown IDs, and that the original gRPC status is still recoverable
+// via status.FromError for callers that need it.
 func TestGetNodeNotFound(t *testing.T) {
 	client, _, cleanup := newTestClient(t)
 	defer cleanup()
 
 	ctx := context.Background()
 
-	_, err := client.GetNode(ctx, "nonexistent-id-99999")
+	node, err := client.GetNode(ctx, "nonexistent-id-99999")
 	if err == nil {
 		t.Fatal("expected error for nonexistent node, got nil")
 	}
+	if node != nil {
+		t.Errorf("expected nil node, got %+v", node)
+	}
+	if !errors.Is(err, ErrNodeNotFound) {
+		t.Errorf("expected errors.Is(err, ErrNodeNotFound) to hold, got: %v", err)
+	}
 
-	// Verify the wrapped error carries NOT_FOUND status.
-	st, ok := status.FromError(unwrapGRPC(err))
+	st, ok := status.FromError(err)
 	if !ok {
-		t.Logf("could not extract gRPC status from error %v (may be wrapped)", err)
-		return // The error exists — that's the key assertion.
+		t.Fatalf("expected status.FromError to recover the gRPC status from %v", err)
 	}
 	if st.Code() != codes.NotFound {
 		t.Errorf("expected NOT_FOUND status, got %v", st.Code())
@@ -353,19 +877,3 @@ func containsStr(s, sub string) bool {
 			return false
 		}())
 }
-
-// unwrapGRPC attempts to find a gRPC status error within a wrapped error chain.
-func unwrapGRPC(err error) error {
-	for err != nil {
-		if _, ok := status.FromError(err); ok {
-			return err
-		}
-		type unwrapper interface{ Unwrap() error }
-		if u, ok := err.(unwrapper); ok {
-			err = u.Unwrap()
-		} else {
-			break
-		}
-	}
-	return err
-}
@@ -0,0 +1,80 @@
+package cortex
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sentinel errors for common failure modes, usable with errors.Is instead
+// of inspecting gRPC status codes directly.
+var (
+	ErrNodeNotFound     = errors.New("cortex: node not found")
+	ErrInvalidArgument  = errors.New("cortex: invalid argument")
+	ErrUnavailable      = errors.New("cortex: service unavailable")
+	ErrPermissionDenied = errors.New("cortex: permission denied")
+	ErrDeadline         = errors.New("cortex: deadline exceeded")
+	ErrAlreadyExists    = errors.New("cortex: already exists")
+)
+
+// statusError wraps a gRPC status behind one of the sentinel errors above.
+// It implements Unwrap so errors.Is(err, cortex.ErrNodeNotFound) works, and
+// GRPCStatus so status.FromError(err) still recovers the original status
+// for callers that need the full detail.
+type statusError struct {
+	sentinel error
+	st       *status.Status
+}
+
+func (e *statusError) Error() string {
+	return e.st.Message()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.sentinel
+}
+
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.st
+}
+
+// wrapErr translates the error returned by the op RPC into a sentinel
+// error where the gRPC status code maps to one, preserving op and the
+// original status. Errors without a recognizable gRPC status (e.g. io.EOF
+// from a stream that ended) and statuses whose code has no sentinel are
+// wrapped with op but left otherwise unchanged.
+func wrapErr(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("cortex: %s: %w", op, err)
+	}
+	sentinel, ok := sentinelForCode(st.Code())
+	if !ok {
+		return fmt.Errorf("cortex: %s: %w", op, err)
+	}
+	return fmt.Errorf("cortex: %s: %w", op, &statusError{sentinel: sentinel, st: st})
+}
+
+func sentinelForCode(code codes.Code) (error, bool) {
+	switch code {
+	case codes.NotFound:
+		return ErrNodeNotFound, true
+	case codes.InvalidArgument:
+		return ErrInvalidArgument, true
+	case codes.Unavailable:
+		return ErrUnavailable, true
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return ErrPermissionDenied, true
+	case codes.DeadlineExceeded:
+		return ErrDeadline, true
+	case codes.AlreadyExists:
+		return ErrAlreadyExists, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,173 @@
+package cortex
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Option configures a Client during Connect.
+type Option func(*connectConfig)
+
+// connectConfig accumulates Option values before Connect dials the server.
+type connectConfig struct {
+	dialOpts        []grpc.DialOption
+	transportCreds  credentials.TransportCredentials
+	perRPCCreds     credentials.PerRPCCredentials
+	retryMaxAttempt int
+	retryInitial    time.Duration
+	retryMax        time.Duration
+	err             error
+}
+
+// WithTLS dials the server over TLS using the given config. If cfg is nil,
+// an empty tls.Config is used (verifying against the system root CAs).
+func WithTLS(cfg *tls.Config) Option {
+	return func(c *connectConfig) {
+		if cfg == nil {
+			cfg = &tls.Config{}
+		}
+		c.transportCreds = credentials.NewTLS(cfg)
+	}
+}
+
+// WithMTLS dials the server over mutually authenticated TLS, presenting the
+// client certificate at certFile/keyFile and verifying the server against
+// the CA bundle at caFile.
+func WithMTLS(certFile, keyFile, caFile string) Option {
+	return func(c *connectConfig) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			c.err = fmt.Errorf("cortex: WithMTLS: load key pair: %w", err)
+			return
+		}
+		caBytes, err := os.ReadFile(caFile)
+		if err != nil {
+			c.err = fmt.Errorf("cortex: WithMTLS: read CA file: %w", err)
+			return
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			c.err = fmt.Errorf("cortex: WithMTLS: no certificates found in %s", caFile)
+			return
+		}
+		c.transportCreds = credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      pool,
+		})
+	}
+}
+
+// WithBearerToken attaches an "authorization: Bearer <token>" header to
+// every outgoing RPC. token is called per-RPC so short-lived JWTs can be
+// refreshed transparently. The credentials require transport security, so
+// gRPC does not send the token over the default insecure connection; combine
+// this option with WithTLS or WithMTLS.
+func WithBearerToken(token func(context.Context) (string, error)) Option {
+	return func(c *connectConfig) {
+		c.perRPCCreds = bearerTokenCreds{token: token}
+	}
+}
+
+// bearerTokenCreds implements credentials.PerRPCCredentials by calling token
+// on every RPC and attaching the result as an authorization header.
+type bearerTokenCreds struct {
+	token func(context.Context) (string, error)
+}
+
+func (b bearerTokenCreds) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	tok, err := b.token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cortex: bearer token: %w", err)
+	}
+	return map[string]string{"authorization": "Bearer " + tok}, nil
+}
+
+func (b bearerTokenCreds) RequireTransportSecurity() bool {
+	return true
+}
+
+// WithKeepalive configures HTTP/2 keepalive pings for the connection.
+func WithKeepalive(params keepalive.ClientParameters) Option {
+	return func(c *connectConfig) {
+		c.dialOpts = append(c.dialOpts, grpc.WithKeepaliveParams(params))
+	}
+}
+
+// WithUnaryInterceptor appends a unary client interceptor, applied in the
+// order supplied across all Option calls.
+func WithUnaryInterceptor(interceptor grpc.UnaryClientInterceptor) Option {
+	return func(c *connectConfig) {
+		c.dialOpts = append(c.dialOpts, grpc.WithChainUnaryInterceptor(interceptor))
+	}
+}
+
+// WithStreamInterceptor appends a stream client interceptor, applied in the
+// order supplied across all Option calls.
+func WithStreamInterceptor(interceptor grpc.StreamClientInterceptor) Option {
+	return func(c *connectConfig) {
+		c.dialOpts = append(c.dialOpts, grpc.WithChainStreamInterceptor(interceptor))
+	}
+}
+
+// WithRetry enables automatic retries of UNAVAILABLE and DEADLINE_EXCEEDED
+// RPCs via gRPC's service config, up to maxAttempts with exponential
+// backoff between initialBackoff and maxBackoff.
+func WithRetry(maxAttempts int, initialBackoff, maxBackoff time.Duration) Option {
+	return func(c *connectConfig) {
+		c.retryMaxAttempt = maxAttempts
+		c.retryInitial = initialBackoff
+		c.retryMax = maxBackoff
+	}
+}
+
+const retryServiceConfigTemplate = `{
+	"methodConfig": [{
+		"name": [{}],
+		"retryPolicy": {
+			"maxAttempts": %d,
+			"initialBackoff": "%s",
+			"maxBackoff": "%s",
+			"backoffMultiplier": 2.0,
+			"retryableStatusCodes": ["UNAVAILABLE", "DEADLINE_EXCEEDED"]
+		}
+	}]
+}`
+
+func (c *connectConfig) buildDialOptions() []grpc.DialOption {
+	opts := make([]grpc.DialOption, 0, len(c.dialOpts)+3)
+
+	if c.transportCreds != nil {
+		opts = append(opts, grpc.WithTransportCredentials(c.transportCreds))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	if c.perRPCCreds != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(c.perRPCCreds))
+	}
+	if c.retryMaxAttempt > 0 {
+		opts = append(opts, grpc.WithDefaultServiceConfig(fmt.Sprintf(
+			retryServiceConfigTemplate, c.retryMaxAttempt,
+			formatGRPCDuration(c.retryInitial), formatGRPCDuration(c.retryMax),
+		)))
+	}
+	opts = append(opts, c.dialOpts...)
+	return opts
+}
+
+// formatGRPCDuration renders d in the protobuf Duration text format gRPC's
+// service-config parser expects (e.g. "0.500000000s"). time.Duration's own
+// %v/String format (e.g. "500ms") is not valid here: the parser strips a
+// trailing "s" and parses what remains as an integer, so "100ms" becomes
+// "100m" and fails to parse.
+func formatGRPCDuration(d time.Duration) string {
+	return fmt.Sprintf("%.9fs", d.Seconds())
+}
@@ -0,0 +1,102 @@
+package cortex
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestWithRetryBuildsServiceConfig verifies that WithRetry records the
+// requested attempt count and that dial options are built from the config.
+// The content of the rendered service config is exercised by
+// TestWithRetryServiceConfigDials.
+func TestWithRetryBuildsServiceConfig(t *testing.T) {
+	cfg := &connectConfig{}
+	WithRetry(5, 100*time.Millisecond, 2*time.Second)(cfg)
+
+	if cfg.retryMaxAttempt != 5 {
+		t.Errorf("retryMaxAttempt = %d, want 5", cfg.retryMaxAttempt)
+	}
+
+	opts := cfg.buildDialOptions()
+	if len(opts) == 0 {
+		t.Fatal("expected dial options to be built")
+	}
+}
+
+// TestWithRetryServiceConfigDials verifies that the service config produced
+// by WithRetry actually parses: grpc.Dial must succeed (and a subsequent
+// RPC must complete) even with a sub-second backoff like
+// 100*time.Millisecond, which previously rendered as the Go-style "100ms"
+// instead of the protobuf-Duration "0.1s" gRPC's parser expects.
+func TestWithRetryServiceConfigDials(t *testing.T) {
+	lis := bufconn.Listen(bufSize)
+	grpcSrv := grpc.NewServer()
+	pb.RegisterCortexServiceServer(grpcSrv, newTestServer())
+	go func() { _ = grpcSrv.Serve(lis) }()
+	defer grpcSrv.Stop()
+
+	cfg := &connectConfig{}
+	WithRetry(5, 100*time.Millisecond, 2*time.Second)(cfg)
+
+	dialOpts := append(cfg.buildDialOptions(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithBlock(),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, "bufnet", dialOpts...)
+	if err != nil {
+		t.Fatalf("dial with retry service config: %v", err)
+	}
+	defer conn.Close()
+
+	svc := pb.NewCortexServiceClient(conn)
+	if _, err := svc.CreateNode(ctx, &pb.CreateNodeRequest{Kind: "fact", Title: "t"}); err != nil {
+		t.Fatalf("CreateNode over retry-configured connection: %v", err)
+	}
+}
+
+// TestWithMTLSMissingFiles verifies that WithMTLS records a configuration
+// error rather than panicking when the cert/key/CA files don't exist.
+func TestWithMTLSMissingFiles(t *testing.T) {
+	cfg := &connectConfig{}
+	WithMTLS("/nonexistent/cert.pem", "/nonexistent/key.pem", "/nonexistent/ca.pem")(cfg)
+
+	if cfg.err == nil {
+		t.Fatal("expected an error for missing mTLS files")
+	}
+	if !strings.Contains(cfg.err.Error(), "WithMTLS") {
+		t.Errorf("expected error to mention WithMTLS, got: %v", cfg.err)
+	}
+}
+
+// TestWithBearerTokenAttachesHeader verifies the PerRPCCredentials wrapper
+// calls the token func and formats the authorization header correctly.
+func TestWithBearerTokenAttachesHeader(t *testing.T) {
+	cfg := &connectConfig{}
+	WithBearerToken(func(_ context.Context) (string, error) {
+		return "abc123", nil
+	})(cfg)
+
+	if cfg.perRPCCreds == nil {
+		t.Fatal("expected perRPCCreds to be set")
+	}
+	md, err := cfg.perRPCCreds.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata: %v", err)
+	}
+	if md["authorization"] != "Bearer abc123" {
+		t.Errorf("authorization header = %q, want %q", md["authorization"], "Bearer abc123")
+	}
+}
@@ -0,0 +1,160 @@
+package cortex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+
+	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
+)
+
+// PeeringConfig describes the slice of this node's graph that a remote
+// Cortex instance should be allowed to subscribe to.
+type PeeringConfig struct {
+	// Kinds restricts the peered slice to nodes of these kinds. Empty means
+	// all kinds.
+	Kinds []string
+	// Tags restricts the peered slice to nodes carrying any of these tags.
+	// Empty means all tags.
+	Tags []string
+	// MinImportance excludes nodes below this importance threshold.
+	MinImportance float32
+	// SinceRevision is the starting point for the peering stream, letting a
+	// peer resume an existing subscription instead of resyncing from
+	// scratch.
+	SinceRevision int64
+}
+
+// PeeringToken is an opaque credential returned by EstablishPeering and
+// presented back to AcceptPeering by the remote instance to complete the
+// handshake.
+type PeeringToken string
+
+// EstablishPeering negotiates a new peering relationship for cfg and
+// returns a PeeringToken the remote instance must present to AcceptPeering
+// to start receiving mutation events.
+func (c *Client) EstablishPeering(ctx context.Context, cfg PeeringConfig) (PeeringToken, error) {
+	resp, err := c.svc.EstablishPeering(ctx, &pb.EstablishPeeringRequest{
+		Kinds:         cfg.Kinds,
+		Tags:          cfg.Tags,
+		MinImportance: cfg.MinImportance,
+		SinceRevision: cfg.SinceRevision,
+	})
+	if err != nil {
+		return "", wrapErr("EstablishPeering", err)
+	}
+	return PeeringToken(resp.Token), nil
+}
+
+// errUnsupportedPeerEventKind marks an applyPeerEvent error as coming from
+// an event kind replication doesn't implement yet, as opposed to a real
+// transport or storage failure. AcceptPeering uses this to tell "this
+// mutation can't be replicated yet" (log and keep the stream alive) apart
+// from "something is actually broken" (abort).
+var errUnsupportedPeerEventKind = errors.New("cortex: peer event kind is not yet replicated")
+
+// AcceptPeering redeems token against a remote Cortex instance and begins
+// replicating its peered slice into this node's graph. It blocks,
+// consuming mutation events, until ctx is cancelled, the peering is
+// rejected, the stream ends, or an event fails to apply; it always returns
+// a non-nil error. Every message on the stream is handled as a single
+// mutation Event; there is no separate snapshot resync handling. Nodes that
+// arrive from the peer are deduplicated against locally-created nodes by content hash, so the
+// same logical node created independently on both sides converges to a
+// single ID rather than duplicating.
+//
+// Event kinds replication doesn't support yet (everything but Created) are
+// logged and skipped rather than torn down with the rest of the stream —
+// in any real graph an update or delete happens almost immediately, and a
+// long-lived peering connection dying on the first one would be worse than
+// the gap it's working around.
+func (c *Client) AcceptPeering(ctx context.Context, token PeeringToken) error {
+	stream, err := c.svc.AcceptPeering(ctx, &pb.AcceptPeeringRequest{Token: string(token)})
+	if err != nil {
+		return wrapErr("AcceptPeering", err)
+	}
+
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return wrapErr("AcceptPeering", err)
+		}
+		if err := c.applyPeerEvent(ctx, ev); err != nil {
+			if errors.Is(err, errUnsupportedPeerEventKind) {
+				log.Printf("cortex: AcceptPeering: skipping event: %v", err)
+				continue
+			}
+			return fmt.Errorf("cortex: AcceptPeering: apply event: %w", err)
+		}
+	}
+}
+
+// applyPeerEvent reconciles a single peer mutation event against the local
+// graph. CreateNode events are deduplicated by content hash so that the
+// same logical node independently created on both sides of a peering
+// relationship converges to one node instead of two.
+//
+// Updated, Deleted, EdgeAdded, and EdgeRemoved are not yet replicated;
+// applyPeerEvent returns an errUnsupportedPeerEventKind-wrapped error for
+// those so AcceptPeering can log and skip them instead of either silently
+// dropping the mutation or tearing down the whole peering stream.
+func (c *Client) applyPeerEvent(ctx context.Context, ev *pb.Event) error {
+	switch EventKind(ev.Kind) {
+	case Created:
+		if ev.Node == nil {
+			return nil
+		}
+		return c.createPeerNode(ctx, ev.Node)
+	case Updated, Deleted, EdgeAdded, EdgeRemoved:
+		return fmt.Errorf("%w: %v", errUnsupportedPeerEventKind, EventKind(ev.Kind))
+	default:
+		return fmt.Errorf("%w: unrecognized kind %d", errUnsupportedPeerEventKind, ev.Kind)
+	}
+}
+
+// createPeerNode replicates a CreateNode event from a peer, skipping the
+// create if a node with the same content hash already exists locally.
+// All replicable fields are copied so that importance/tag filters applied
+// via PeeringConfig on the sending side stay meaningful on this side too.
+func (c *Client) createPeerNode(ctx context.Context, node *pb.NodeResponse) error {
+	existing, err := c.findByContentHash(ctx, contentHash(node.Kind, node.Title, node.Body))
+	if err != nil {
+		return err
+	}
+	if existing != "" {
+		return nil
+	}
+
+	_, err = c.CreateNode(ctx, Node{
+		Kind:        node.Kind,
+		Title:       node.Title,
+		Body:        node.Body,
+		Tags:        node.Tags,
+		Importance:  node.Importance,
+		SourceAgent: node.SourceAgent,
+		Metadata:    node.Metadata,
+	})
+	return err
+}
+
+// findByContentHash looks up a node previously created with the given
+// content hash, returning its ID or "" if none exists locally.
+func (c *Client) findByContentHash(ctx context.Context, hash string) (string, error) {
+	resp, err := c.svc.FindByContentHash(ctx, &pb.FindByContentHashRequest{ContentHash: hash})
+	if err != nil {
+		return "", wrapErr("FindByContentHash", err)
+	}
+	return resp.Id, nil
+}
+
+// contentHash computes the deterministic dedup key for a node: sha256 of
+// "kind|title|body". Both peers compute this the same way so that a node
+// created independently on each side is recognized as the same logical
+// node rather than replicated as a duplicate.
+func contentHash(kind, title, body string) string {
+	sum := sha256.Sum256([]byte(kind + "|" + title + "|" + body))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,199 @@
+package cortex
+
+import (
+	"context"
+	"io"
+
+	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
+)
+
+// SearchStreamItem is one element of a SearchStream channel. Err is set,
+// and Result is the zero value, when the stream ended because of a
+// mid-stream failure rather than running to completion; callers that only
+// care about the happy path can ignore it, but anything that needs to
+// distinguish "got everything" from "connection dropped partway through"
+// should check it once the channel closes.
+type SearchStreamItem struct {
+	Result SearchResult
+	Err    error
+}
+
+// SearchStream performs semantic similarity search like Search, but streams
+// results back as they are ranked instead of buffering the full result set.
+// The returned channel is closed when the stream ends, whether that's
+// because the server finished, ctx was cancelled, or the stream broke
+// mid-flight; in the last case the final item carries the error in its Err
+// field instead of the channel closing silently. The cancel func releases
+// the underlying HTTP/2 stream and must be called once the caller is done
+// consuming, even if it drains the channel to completion.
+func (c *Client) SearchStream(ctx context.Context, query string, limit int) (<-chan SearchStreamItem, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.svc.SearchStream(ctx, &pb.SimilaritySearchRequest{
+		Query: query,
+		Limit: uint32(limit),
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, wrapErr("SearchStream", err)
+	}
+
+	out := make(chan SearchStreamItem)
+	go func() {
+		defer close(out)
+		for {
+			entry, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					select {
+					case out <- SearchStreamItem{Err: wrapErr("SearchStream", err)}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			n := entry.Node
+			if n == nil {
+				continue
+			}
+			select {
+			case out <- SearchStreamItem{Result: SearchResult{
+				Score:      entry.Score,
+				NodeID:     n.Id,
+				Title:      n.Title,
+				Kind:       n.Kind,
+				Body:       n.Body,
+				Importance: n.Importance,
+			}}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, cancel, nil
+}
+
+// HybridStreamItem is one element of a SearchHybridStream channel. See
+// SearchStreamItem: Err carries a mid-stream failure instead of the channel
+// just closing on it.
+type HybridStreamItem struct {
+	Result HybridResult
+	Err    error
+}
+
+// SearchHybridStream streams hybrid (vector + graph) search results as they
+// are produced. See SearchStream for channel and cancellation semantics.
+func (c *Client) SearchHybridStream(
+	ctx context.Context,
+	query string,
+	anchorIDs []string,
+	limit int,
+) (<-chan HybridStreamItem, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.svc.HybridSearchStream(ctx, &pb.HybridSearchRequest{
+		Query:     query,
+		AnchorIds: anchorIDs,
+		Limit:     uint32(limit),
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, wrapErr("SearchHybridStream", err)
+	}
+
+	out := make(chan HybridStreamItem)
+	go func() {
+		defer close(out)
+		for {
+			entry, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					select {
+					case out <- HybridStreamItem{Err: wrapErr("SearchHybridStream", err)}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			n := entry.Node
+			if n == nil {
+				continue
+			}
+			select {
+			case out <- HybridStreamItem{Result: HybridResult{
+				CombinedScore: entry.CombinedScore,
+				VectorScore:   entry.VectorScore,
+				GraphScore:    entry.GraphScore,
+				NodeID:        n.Id,
+				Title:         n.Title,
+				Kind:          n.Kind,
+			}}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, cancel, nil
+}
+
+// TraverseFrame is one batch of a streamed graph traversal: a set of nodes
+// and edges discovered at this point in the walk, plus whether the server
+// truncated expansion for this frame.
+type TraverseFrame struct {
+	NodeIDs   []string
+	EdgeCount int
+	Truncated bool
+}
+
+// TraverseStreamItem is one element of a TraverseStream channel. See
+// SearchStreamItem: Err carries a mid-stream failure instead of the channel
+// just closing on it.
+type TraverseStreamItem struct {
+	Frame TraverseFrame
+	Err   error
+}
+
+// TraverseStream performs a graph traversal like Traverse, but streams
+// frames as the server expands the graph instead of waiting for the full
+// subgraph. This lets callers start acting on nearby nodes while a deep or
+// wide traversal is still in flight.
+func (c *Client) TraverseStream(ctx context.Context, startID string, depth uint32) (<-chan TraverseStreamItem, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.svc.TraverseStream(ctx, &pb.TraverseRequest{
+		StartIds: []string{startID},
+		MaxDepth: depth,
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, wrapErr("TraverseStream", err)
+	}
+
+	out := make(chan TraverseStreamItem)
+	go func() {
+		defer close(out)
+		for {
+			frame, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					select {
+					case out <- TraverseStreamItem{Err: wrapErr("TraverseStream", err)}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			ids := make([]string, len(frame.Nodes))
+			for i, n := range frame.Nodes {
+				ids[i] = n.Id
+			}
+			select {
+			case out <- TraverseStreamItem{Frame: TraverseFrame{
+				NodeIDs:   ids,
+				EdgeCount: len(frame.Edges),
+				Truncated: frame.Truncated,
+			}}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, cancel, nil
+}
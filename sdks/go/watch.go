@@ -0,0 +1,129 @@
+package cortex
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/MikeSquared-Agency/cortex/sdks/go/proto"
+)
+
+// EventKind identifies the kind of graph mutation carried by an Event.
+type EventKind int
+
+const (
+	Created EventKind = iota
+	Updated
+	Deleted
+	EdgeAdded
+	EdgeRemoved
+)
+
+// Event describes a single mutation observed on a watched slice of the
+// graph. Node is set for Created/Updated/Deleted; Edge is set for
+// EdgeAdded/EdgeRemoved. Revision is a monotonically increasing counter
+// assigned by the server, usable as a resume point via
+// WatchRequest.SinceRevision.
+type Event struct {
+	Kind     EventKind
+	Node     *pb.NodeResponse
+	Edge     *pb.EdgeResponse
+	Revision int64
+}
+
+// WatchRequest filters the mutations delivered by Client.Watch.
+type WatchRequest struct {
+	// Kinds restricts events to nodes of these kinds. Empty means all kinds.
+	Kinds []string
+	// Tags restricts events to nodes carrying any of these tags. Empty means
+	// all tags.
+	Tags []string
+	// SourceAgents restricts events to nodes created by these agents. Empty
+	// means all agents.
+	SourceAgents []string
+	// SinceRevision resumes the watch from just after this revision,
+	// skipping mutations the caller has already seen. Zero starts from the
+	// current state of the graph.
+	SinceRevision int64
+}
+
+// watchReconnectInitialBackoff and watchReconnectMaxBackoff bound the
+// exponential backoff Watch uses when the underlying stream is lost.
+const (
+	watchReconnectInitialBackoff = 500 * time.Millisecond
+	watchReconnectMaxBackoff     = 30 * time.Second
+)
+
+// Watch subscribes to mutations on a slice of the graph matching req,
+// returning a channel of Events. If the underlying stream is lost (e.g. a
+// server restart), Watch automatically reconnects with exponential
+// backoff, resuming from the last delivered revision so no events are
+// missed or duplicated across the reconnect. The channel is closed when
+// ctx is cancelled.
+func (c *Client) Watch(ctx context.Context, req WatchRequest) (<-chan Event, error) {
+	stream, err := c.watchFrom(ctx, req.Kinds, req.Tags, req.SourceAgents, req.SinceRevision)
+	if err != nil {
+		return nil, wrapErr("Watch", err)
+	}
+
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+
+		sinceRevision := req.SinceRevision
+		backoff := watchReconnectInitialBackoff
+
+		for {
+			for {
+				ev, recvErr := stream.Recv()
+				if recvErr != nil {
+					break
+				}
+				event := Event{
+					Kind:     EventKind(ev.Kind),
+					Node:     ev.Node,
+					Edge:     ev.Edge,
+					Revision: ev.Revision,
+				}
+				sinceRevision = event.Revision
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			backoff = watchReconnectInitialBackoff
+
+			for {
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return
+				}
+
+				var reconnectErr error
+				stream, reconnectErr = c.watchFrom(ctx, req.Kinds, req.Tags, req.SourceAgents, sinceRevision)
+				if reconnectErr == nil {
+					break
+				}
+				backoff *= 2
+				if backoff > watchReconnectMaxBackoff {
+					backoff = watchReconnectMaxBackoff
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (c *Client) watchFrom(ctx context.Context, kinds, tags, sourceAgents []string, sinceRevision int64) (pb.CortexService_WatchClient, error) {
+	return c.svc.Watch(ctx, &pb.WatchRequest{
+		Kinds:         kinds,
+		Tags:          tags,
+		SourceAgents:  sourceAgents,
+		SinceRevision: sinceRevision,
+	})
+}